@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceAwsRoute53VPCAssociationAuthorizations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRoute53VPCAssociationAuthorizationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"vpcs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsRoute53VPCAssociationAuthorizationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	zoneID := d.Get("zone_id").(string)
+
+	vpcs, err := route53ListVPCAssociationAuthorizations(conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 VPC Association Authorizations for Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	if err := d.Set("vpcs", flattenRoute53VPCAssociationAuthorizations(vpcs)); err != nil {
+		return fmt.Errorf("error setting vpcs: %s", err)
+	}
+
+	d.SetId(zoneID)
+
+	return nil
+}
+
+func flattenRoute53VPCAssociationAuthorizations(vpcs []*route53.VPC) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(vpcs))
+
+	for _, vpc := range vpcs {
+		results = append(results, map[string]interface{}{
+			"vpc_id":     aws.StringValue(vpc.VPCId),
+			"vpc_region": aws.StringValue(vpc.VPCRegion),
+		})
+	}
+
+	return results
+}
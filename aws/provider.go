@@ -0,0 +1,19 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_ecs_capacity_provider":                 resourceAwsEcsCapacityProvider(),
+			"aws_ecs_cluster_capacity_providers":        resourceAwsEcsClusterCapacityProviders(),
+			"aws_route53_vpc_association_authorization": resourceAwsRoute53CreateVPCAssociationAuthorization(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_route53_vpc_association_authorizations": dataSourceAwsRoute53VPCAssociationAuthorizations(),
+		},
+	}
+}
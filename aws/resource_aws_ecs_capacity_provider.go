@@ -3,10 +3,13 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
@@ -17,10 +20,16 @@ func resourceAwsEcsCapacityProvider() *schema.Resource {
 		Create: resourceAwsEcsCapacityProviderCreate,
 		Read:   resourceAwsEcsCapacityProviderRead,
 		Update: resourceAwsEcsCapacityProviderUpdate,
-		Delete: schema.Noop,
+		Delete: resourceAwsEcsCapacityProviderDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsEcsCapacityProviderImport,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -51,6 +60,15 @@ func resourceAwsEcsCapacityProvider() *schema.Resource {
 								"DISABLED",
 							}, false),
 						},
+						"managed_draining": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ENABLED",
+								"DISABLED",
+							}, false),
+						},
 						"managed_scaling": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
@@ -84,6 +102,12 @@ func resourceAwsEcsCapacityProvider() *schema.Resource {
 										Computed:     true,
 										ValidateFunc: validation.IntBetween(1, 100),
 									},
+									"instance_warmup_period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(0, 10000),
+									},
 								},
 							},
 						},
@@ -168,6 +192,22 @@ func resourceAwsEcsCapacityProviderRead(d *schema.ResourceData, meta interface{}
 func resourceAwsEcsCapacityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
+	if d.HasChange("auto_scaling_group_provider") {
+		input := &ecs.UpdateCapacityProviderInput{
+			Name:                     aws.String(d.Get("name").(string)),
+			AutoScalingGroupProvider: expandCapacityProviderAutoScalingGroupProviderUpdate(d.Get("auto_scaling_group_provider")),
+		}
+
+		log.Printf("[DEBUG] Updating ECS Capacity Provider: %s", input)
+		if _, err := conn.UpdateCapacityProvider(input); err != nil {
+			return fmt.Errorf("error updating ECS Capacity Provider (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForEcsCapacityProviderUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for ECS Capacity Provider (%s) update: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
 
@@ -176,10 +216,192 @@ func resourceAwsEcsCapacityProviderUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	return resourceAwsEcsCapacityProviderRead(d, meta)
+}
+
+func expandCapacityProviderAutoScalingGroupProviderUpdate(configured interface{}) *ecs.AutoScalingGroupProviderUpdate {
+	full := expandAutoScalingGroupProvider(configured)
+	if full == nil {
+		return nil
+	}
+
+	return &ecs.AutoScalingGroupProviderUpdate{
+		ManagedTerminationProtection: full.ManagedTerminationProtection,
+		ManagedDraining:              full.ManagedDraining,
+		ManagedScaling:               full.ManagedScaling,
+	}
+}
+
+func resourceAwsEcsCapacityProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	input := &ecs.DeleteCapacityProviderInput{
+		CapacityProvider: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteCapacityProvider(input)
+
+	if isAWSErr(err, ecs.ErrCodeMissingVersionException, "") {
+		return nil
+	}
+
+	if isResourceNotFoundError(err) {
+		return nil
+	}
+
+	if isAWSErr(err, ecs.ErrCodeClusterContainsTasksException, "") {
+		clusters, lookupErr := ecsClustersUsingCapacityProvider(conn, d.Get("name").(string))
+
+		if lookupErr != nil {
+			return fmt.Errorf("error deleting ECS Capacity Provider (%s): still in use: %s", d.Id(), err)
+		}
+
+		return fmt.Errorf("error deleting ECS Capacity Provider (%s): still in use by cluster(s) %s: %s", d.Id(), strings.Join(clusters, ", "), err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECS Capacity Provider (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEcsCapacityProviderDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for ECS Capacity Provider (%s) deletion: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
-// TODO write delete once it's implemented in the API
+func ecsClustersUsingCapacityProvider(conn *ecs.ECS, name string) ([]string, error) {
+	var clusterArns []*string
+	input := &ecs.ListClustersInput{}
+	for {
+		output, err := conn.ListClusters(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		clusterArns = append(clusterArns, output.ClusterArns...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	if len(clusterArns) == 0 {
+		return nil, nil
+	}
+
+	output, err := conn.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: clusterArns,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, cluster := range output.Clusters {
+		for _, cp := range cluster.CapacityProviders {
+			if aws.StringValue(cp) == name {
+				matches = append(matches, aws.StringValue(cluster.ClusterName))
+				break
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func waitForEcsCapacityProviderDeletion(conn *ecs.ECS, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ecs.CapacityProviderStatusActive},
+		Target:     []string{ecs.CapacityProviderStatusInactive},
+		Refresh:    ecsCapacityProviderStatusRefreshFunc(conn, arn),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEcsCapacityProviderUpdate(conn *ecs.ECS, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"", ecs.CapacityProviderUpdateStatusUpdateInProgress},
+		Target:     []string{ecs.CapacityProviderUpdateStatusUpdateComplete},
+		Refresh:    ecsCapacityProviderUpdateStatusRefreshFunc(conn, arn),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func ecsCapacityProviderUpdateStatusRefreshFunc(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecs.DescribeCapacityProvidersInput{
+			CapacityProviders: []*string{aws.String(arn)},
+		}
+
+		output, err := conn.DescribeCapacityProviders(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var provider *ecs.CapacityProvider
+		for _, cp := range output.CapacityProviders {
+			if aws.StringValue(cp.CapacityProviderArn) == arn {
+				provider = cp
+				break
+			}
+		}
+
+		if provider == nil {
+			return nil, "", fmt.Errorf("ECS Capacity Provider (%s) disappeared during update", arn)
+		}
+
+		if aws.StringValue(provider.UpdateStatus) == ecs.CapacityProviderUpdateStatusUpdateFailed {
+			return provider, ecs.CapacityProviderUpdateStatusUpdateFailed, fmt.Errorf("update failed: %s", aws.StringValue(provider.UpdateStatusReason))
+		}
+
+		return provider, aws.StringValue(provider.UpdateStatus), nil
+	}
+}
+
+func ecsCapacityProviderStatusRefreshFunc(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecs.DescribeCapacityProvidersInput{
+			CapacityProviders: []*string{aws.String(arn)},
+		}
+
+		output, err := conn.DescribeCapacityProviders(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var provider *ecs.CapacityProvider
+		for _, cp := range output.CapacityProviders {
+			if aws.StringValue(cp.CapacityProviderArn) == arn {
+				provider = cp
+				break
+			}
+		}
+
+		if provider == nil {
+			return "", ecs.CapacityProviderStatusInactive, nil
+		}
+
+		return provider, aws.StringValue(provider.Status), nil
+	}
+}
 
 func resourceAwsEcsCapacityProviderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("name", d.Id())
@@ -194,9 +416,14 @@ func resourceAwsEcsCapacityProviderImport(d *schema.ResourceData, meta interface
 }
 
 func expandAutoScalingGroupProvider(configured interface{}) *ecs.AutoScalingGroupProvider {
+	list := configured.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
 	prov := ecs.AutoScalingGroupProvider{}
 
-	p := configured.([]interface{})[0].(map[string]interface{})
+	p := list[0].(map[string]interface{})
 	arn := p["auto_scaling_group_arn"].(string)
 	prov.AutoScalingGroupArn = aws.String(arn)
 
@@ -204,6 +431,10 @@ func expandAutoScalingGroupProvider(configured interface{}) *ecs.AutoScalingGrou
 		prov.ManagedTerminationProtection = aws.String(mtp)
 	}
 
+	if md := p["managed_draining"].(string); len(md) > 0 {
+		prov.ManagedDraining = aws.String(md)
+	}
+
 	if val := p["managed_scaling"].([]interface{}); len(val) > 0 {
 		if ms, ok := val[0].(map[string]interface{}); ok {
 			managedScaling := ecs.ManagedScaling{}
@@ -220,6 +451,9 @@ func expandAutoScalingGroupProvider(configured interface{}) *ecs.AutoScalingGrou
 			if val, ok := ms["target_capacity"].(int); ok && val != 0 {
 				managedScaling.TargetCapacity = aws.Int64(int64(val))
 			}
+			if val, ok := ms["instance_warmup_period"].(int); ok && val != 0 {
+				managedScaling.InstanceWarmupPeriod = aws.Int64(int64(val))
+			}
 			prov.ManagedScaling = &managedScaling
 		}
 	}
@@ -236,6 +470,7 @@ func flattenAutoScalingGroupProvider(provider *ecs.AutoScalingGroupProvider) []m
 	p := make(map[string]interface{}, 0)
 	p["auto_scaling_group_arn"] = aws.StringValue(provider.AutoScalingGroupArn)
 	p["managed_termination_protection"] = aws.StringValue(provider.ManagedTerminationProtection)
+	p["managed_draining"] = aws.StringValue(provider.ManagedDraining)
 
 	ms := make(map[string]interface{}, 0)
 	msl := make([]map[string]interface{}, 0)
@@ -243,6 +478,7 @@ func flattenAutoScalingGroupProvider(provider *ecs.AutoScalingGroupProvider) []m
 	ms["minimum_scaling_step_size"] = aws.Int64Value(provider.ManagedScaling.MinimumScalingStepSize)
 	ms["status"] = aws.StringValue(provider.ManagedScaling.Status)
 	ms["target_capacity"] = aws.Int64Value(provider.ManagedScaling.TargetCapacity)
+	ms["instance_warmup_period"] = aws.Int64Value(provider.ManagedScaling.InstanceWarmupPeriod)
 	msl = append(msl, ms)
 
 	p["managed_scaling"] = msl
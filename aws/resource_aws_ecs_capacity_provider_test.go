@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAWSEcsCapacityProvider_update(t *testing.T) {
+	var provider1, provider2 ecs.CapacityProvider
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_capacity_provider.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsCapacityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsCapacityProviderConfig(rName, 1, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsCapacityProviderExists(resourceName, &provider1),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_scaling.0.target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_termination_protection", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccAWSEcsCapacityProviderConfig(rName, 50, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsCapacityProviderExists(resourceName, &provider2),
+					testAccCheckAWSEcsCapacityProviderNotRecreated(&provider1, &provider2),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_scaling.0.target_capacity", "50"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_termination_protection", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsCapacityProvider_disappears_whileInUseByCluster(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsCapacityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsCapacityProviderInUseByClusterConfig(rName),
+			},
+			{
+				Config:      testAccAWSEcsCapacityProviderInUseByClusterConfig(rName),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`still in use`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcsCapacityProviderExists(resourceName string, provider *ecs.CapacityProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		output, err := conn.DescribeCapacityProviders(&ecs.DescribeCapacityProvidersInput{
+			CapacityProviders: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, cp := range output.CapacityProviders {
+			if aws.StringValue(cp.CapacityProviderArn) == rs.Primary.ID {
+				*provider = *cp
+				return nil
+			}
+		}
+
+		return fmt.Errorf("ECS Capacity Provider %s not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSEcsCapacityProviderNotRecreated(before, after *ecs.CapacityProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.CapacityProviderArn) != aws.StringValue(after.CapacityProviderArn) {
+			return fmt.Errorf("ECS Capacity Provider was recreated: %s -> %s", aws.StringValue(before.CapacityProviderArn), aws.StringValue(after.CapacityProviderArn))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEcsCapacityProviderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecs_capacity_provider" {
+			continue
+		}
+
+		output, err := conn.DescribeCapacityProviders(&ecs.DescribeCapacityProvidersInput{
+			CapacityProviders: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, cp := range output.CapacityProviders {
+			if aws.StringValue(cp.CapacityProviderArn) == rs.Primary.ID && aws.StringValue(cp.Status) != ecs.CapacityProviderStatusInactive {
+				return fmt.Errorf("ECS Capacity Provider %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEcsCapacityProviderConfig(rName string, targetCapacity int, managedTerminationProtection string) string {
+	return fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  name                 = %[1]q
+  availability_zones   = data.aws_availability_zones.available.names
+  min_size             = 0
+  max_size             = 1
+  launch_configuration = aws_launch_configuration.test.name
+
+  tag {
+    key                 = "AmazonECSManaged"
+    value               = true
+    propagate_at_launch = true
+  }
+}
+
+resource "aws_launch_configuration" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t2.micro"
+}
+
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn         = aws_autoscaling_group.test.arn
+    managed_termination_protection = %[3]q
+
+    managed_scaling {
+      target_capacity = %[2]d
+    }
+  }
+}
+`, rName, targetCapacity, managedTerminationProtection)
+}
+
+func testAccAWSEcsCapacityProviderInUseByClusterConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  name                 = %[1]q
+  availability_zones   = data.aws_availability_zones.available.names
+  min_size             = 0
+  max_size             = 1
+  launch_configuration = aws_launch_configuration.test.name
+}
+
+resource "aws_launch_configuration" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t2.micro"
+}
+
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn = aws_autoscaling_group.test.arn
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name       = aws_ecs_cluster.test.name
+  capacity_providers = [aws_ecs_capacity_provider.test.name]
+}
+`, rName)
+}
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsEcsClusterCapacityProviders() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcsClusterCapacityProvidersPut,
+		Read:   resourceAwsEcsClusterCapacityProvidersRead,
+		Update: resourceAwsEcsClusterCapacityProvidersPut,
+		Delete: resourceAwsEcsClusterCapacityProvidersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"capacity_providers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_capacity_provider_strategy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsEcsClusterCapacityProvidersPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	clusterName := d.Get("cluster_name").(string)
+
+	input := &ecs.PutClusterCapacityProvidersInput{
+		Cluster:                         aws.String(clusterName),
+		CapacityProviders:               expandStringSet(d.Get("capacity_providers").(*schema.Set)),
+		DefaultCapacityProviderStrategy: expandEcsCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Updating ECS Cluster Capacity Providers: %s", input)
+	_, err := conn.PutClusterCapacityProviders(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating ECS Cluster (%s) capacity providers: %s", clusterName, err)
+	}
+
+	d.SetId(clusterName)
+
+	return resourceAwsEcsClusterCapacityProvidersRead(d, meta)
+}
+
+func resourceAwsEcsClusterCapacityProvidersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	input := &ecs.DescribeClustersInput{
+		Clusters: []*string{aws.String(d.Id())},
+	}
+
+	output, err := conn.DescribeClusters(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading ECS Cluster (%s): %s", d.Id(), err)
+	}
+
+	var cluster *ecs.Cluster
+	for _, c := range output.Clusters {
+		if aws.StringValue(c.ClusterName) == d.Id() {
+			cluster = c
+			break
+		}
+	}
+
+	if cluster == nil || aws.StringValue(cluster.Status) == "INACTIVE" {
+		log.Printf("[WARN] ECS Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_name", cluster.ClusterName)
+	d.Set("capacity_providers", aws.StringValueSlice(cluster.CapacityProviders))
+
+	if err := d.Set("default_capacity_provider_strategy", flattenEcsCapacityProviderStrategy(cluster.DefaultCapacityProviderStrategy)); err != nil {
+		return fmt.Errorf("error setting default_capacity_provider_strategy: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEcsClusterCapacityProvidersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	input := &ecs.PutClusterCapacityProvidersInput{
+		Cluster:                         aws.String(d.Id()),
+		CapacityProviders:               []*string{},
+		DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{},
+	}
+
+	log.Printf("[DEBUG] Clearing ECS Cluster Capacity Providers: %s", input)
+	_, err := conn.PutClusterCapacityProviders(input)
+
+	if isResourceNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error clearing ECS Cluster (%s) capacity providers: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEcsCapacityProviderStrategy(set *schema.Set) []*ecs.CapacityProviderStrategyItem {
+	if set.Len() == 0 {
+		return nil
+	}
+
+	items := make([]*ecs.CapacityProviderStrategyItem, 0, set.Len())
+	for _, raw := range set.List() {
+		p := raw.(map[string]interface{})
+		items = append(items, &ecs.CapacityProviderStrategyItem{
+			Base:             aws.Int64(int64(p["base"].(int))),
+			CapacityProvider: aws.String(p["capacity_provider"].(string)),
+			Weight:           aws.Int64(int64(p["weight"].(int))),
+		})
+	}
+
+	return items
+}
+
+func flattenEcsCapacityProviderStrategy(items []*ecs.CapacityProviderStrategyItem) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(items))
+
+	for _, item := range items {
+		results = append(results, map[string]interface{}{
+			"base":              aws.Int64Value(item.Base),
+			"capacity_provider": aws.StringValue(item.CapacityProvider),
+			"weight":            aws.Int64Value(item.Weight),
+		})
+	}
+
+	return results
+}
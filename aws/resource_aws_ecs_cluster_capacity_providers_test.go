@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAWSEcsClusterCapacityProviders_basic(t *testing.T) {
+	var cluster1, cluster2 ecs.Cluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsClusterCapacityProvidersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsClusterCapacityProvidersConfig(rName, 1, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterCapacityProvidersExists(resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSEcsClusterCapacityProvidersConfig(rName, 5, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterCapacityProvidersExists(resourceName, &cluster2),
+					testAccCheckAWSEcsClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsClusterCapacityProviders_fargate(t *testing.T) {
+	var cluster ecs.Cluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsClusterCapacityProvidersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsClusterCapacityProvidersFargateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterCapacityProvidersExists(resourceName, &cluster),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capacity_providers.*", "FARGATE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capacity_providers.*", "FARGATE_SPOT"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_capacity_provider_strategy.*", map[string]string{
+						"capacity_provider": "FARGATE",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcsClusterCapacityProvidersExists(resourceName string, cluster *ecs.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		output, err := conn.DescribeClusters(&ecs.DescribeClustersInput{
+			Clusters: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, c := range output.Clusters {
+			if aws.StringValue(c.ClusterName) == rs.Primary.ID {
+				*cluster = *c
+				return nil
+			}
+		}
+
+		return fmt.Errorf("ECS Cluster %s not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSEcsClusterNotRecreated(before, after *ecs.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.ClusterArn) != aws.StringValue(after.ClusterArn) {
+			return fmt.Errorf("ECS Cluster was recreated: %s -> %s", aws.StringValue(before.ClusterArn), aws.StringValue(after.ClusterArn))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEcsClusterCapacityProvidersDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecs_cluster_capacity_providers" {
+			continue
+		}
+
+		output, err := conn.DescribeClusters(&ecs.DescribeClustersInput{
+			Clusters: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, c := range output.Clusters {
+			if aws.StringValue(c.ClusterName) == rs.Primary.ID && aws.StringValue(c.Status) != "INACTIVE" && len(c.CapacityProviders) > 0 {
+				return fmt.Errorf("ECS Cluster %s still has capacity providers associated: %v", rs.Primary.ID, aws.StringValueSlice(c.CapacityProviders))
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEcsClusterCapacityProvidersConfig(rName string, weight, base int) string {
+	return fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  name                 = %[1]q
+  availability_zones   = data.aws_availability_zones.available.names
+  min_size             = 0
+  max_size             = 1
+  launch_configuration = aws_launch_configuration.test.name
+
+  tag {
+    key                 = "AmazonECSManaged"
+    value               = true
+    propagate_at_launch = true
+  }
+}
+
+resource "aws_launch_configuration" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t2.micro"
+}
+
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn = aws_autoscaling_group.test.arn
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name       = aws_ecs_cluster.test.name
+  capacity_providers = [aws_ecs_capacity_provider.test.name]
+
+  default_capacity_provider_strategy {
+    capacity_provider = aws_ecs_capacity_provider.test.name
+    weight            = %[2]d
+    base              = %[3]d
+  }
+}
+`, rName, weight, base)
+}
+
+func testAccAWSEcsClusterCapacityProvidersFargateConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name       = aws_ecs_cluster.test.name
+  capacity_providers = ["FARGATE", "FARGATE_SPOT"]
+
+  default_capacity_provider_strategy {
+    capacity_provider = "FARGATE"
+    weight            = 1
+  }
+}
+`, rName)
+}
@@ -6,15 +6,13 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	// "time"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	// "github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/route53"
-
-	// "github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	// "github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/aws/aws-sdk-go/service/route53"
 )
 
 // const defaultAuthorizerTTL = 300
@@ -27,6 +25,11 @@ func resourceAwsRoute53CreateVPCAssociationAuthorization() *schema.Resource {
 		Delete: resourceAwsRoute53CreateVPCAssociationAuthorizationDelete,
 		// CustomizeDiff: resourceAwsRoute53CreateVPCAssociationAuthorizationCustomizeDiff, // is this needed?
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -68,7 +71,29 @@ func resourceAwsRoute53CreateVPCAssociationAuthorizationCreate(d *schema.Resourc
 	}
 
 	log.Printf("[INFO] Creating VPC Association Authorization: %s", input)
-	_, err := conn.CreateVPCAssociationAuthorization(&input)
+	var err error
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateVPCAssociationAuthorization(&input)
+
+		if isAWSErr(err, route53.ErrCodeConcurrentModification, "") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, route53.ErrCodeThrottlingException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.CreateVPCAssociationAuthorization(&input)
+	}
+
 	if err != nil {
 		return fmt.Errorf("Error creating VPC Association Authorization: %s", err)
 	}
@@ -76,27 +101,21 @@ func resourceAwsRoute53CreateVPCAssociationAuthorizationCreate(d *schema.Resourc
 	// Store association id
 	d.SetId(fmt.Sprintf("%s:%s", *input.HostedZoneId, *input.VPC.VPCId))
 
-	// Not sure how to get the Refresh field sorted out as output from above
-	// does not include a ChangeInfo field
-	//
-	// Wait until we are done initializing
-	// wait := resource.StateChangeConf{
-	// 	Delay:      30 * time.Second,
-	// 	Pending:    []string{"PENDING"},
-	// 	Target:     []string{"INSYNC"},
-	// 	Timeout:    10 * time.Minute,
-	// 	MinTimeout: 2 * time.Second,
-	// 	Refresh: func() (result interface{}, state string, err error) {
-	// 		changeRequest := &route53.GetChangeInput{
-	// 			Id: aws.String(cleanChangeID(*out.ChangeInfo.Id)),
-	// 		}
-	// 		return resourceAwsGoRoute53Wait(conn, changeRequest)
-	// 	},
-	// }
-	// _, err = wait.WaitForState()
-	// if err != nil {
-	// 	return err
-	// }
+	// CreateVPCAssociationAuthorization does not return a ChangeInfo, so the
+	// only way to know the authorization is visible to the associating
+	// account is to poll ListVPCAssociationAuthorizations until the VPC
+	// shows up.
+	wait := resource.StateChangeConf{
+		Delay:      10 * time.Second,
+		Pending:    []string{"PENDING"},
+		Target:     []string{"INSYNC"},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 2 * time.Second,
+		Refresh:    resourceAwsRoute53VPCAssociationAuthorizationRefreshFunc(conn, *input.HostedZoneId, *input.VPC.VPCId, true),
+	}
+	if _, err := wait.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for VPC Association Authorization (%s) to be authorized: %s", d.Id(), err)
+	}
 
 	return resourceAwsRoute53CreateVPCAssociationAuthorizationRead(d, meta)
 }
@@ -120,7 +139,6 @@ func resourceAwsRoute53CreateVPCAssociationAuthorizationRead(d *schema.ResourceD
 		return nil
 	}
 	// ErrCodeInvalidInput case should be handled by explicit validation check within route53GetVPCAssociation
-	// ErrCodeInvalidPaginationToken case is not a possibility as the NextToken optional input is not yet supported in this package
 
 	if err != nil {
 		return fmt.Errorf("error getting Route 53 VPC (%s) Association Authorization for Hosted Zone (%s): %s", vpcID, zoneID, err)
@@ -159,11 +177,76 @@ func resourceAwsRoute53CreateVPCAssociationAuthorizationDelete(d *schema.Resourc
 		},
 	}
 
-	_, err = conn.DeleteVPCAssociationAuthorization(input)
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteVPCAssociationAuthorization(input)
+
+		if isAWSErr(err, route53.ErrCodeConcurrentModification, "") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, route53.ErrCodeThrottlingException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteVPCAssociationAuthorization(input)
+	}
+
+	if isAWSErr(err, route53.ErrCodeVPCAssociationAuthorizationNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deauthorizing Route 53 VPC (%s) Association for Hosted Zone (%s): %s", vpcID, zoneID, err)
+	}
+
+	wait := resource.StateChangeConf{
+		Delay:      10 * time.Second,
+		Pending:    []string{"PENDING"},
+		Target:     []string{"DEAUTHORIZED"},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 2 * time.Second,
+		Refresh:    resourceAwsRoute53VPCAssociationAuthorizationRefreshFunc(conn, zoneID, vpcID, false),
+	}
+	if _, err := wait.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for VPC Association Authorization (%s) to be deauthorized: %s", d.Id(), err)
+	}
 
 	return nil
 }
 
+// authorizing distinguishes the two directions this refresh func is used
+// for: true while waiting for the VPC to appear (Create, target "INSYNC"),
+// false while waiting for it to disappear (Delete, target "DEAUTHORIZED").
+func resourceAwsRoute53VPCAssociationAuthorizationRefreshFunc(conn *route53.Route53, zoneID, vpcID string, authorizing bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vpc, err := route53GetVPCAssociation(conn, zoneID, vpcID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if authorizing {
+			if vpc == nil {
+				return "", "PENDING", nil
+			}
+			return vpc, "INSYNC", nil
+		}
+
+		if vpc != nil {
+			return vpc, "PENDING", nil
+		}
+		return "", "DEAUTHORIZED", nil
+	}
+}
+
 func resourceAwsRoute53VPCAssociationAuthorizationParseId(id string) (string, string, error) {
 	parts := strings.Split(id, ":")
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -173,26 +256,14 @@ func resourceAwsRoute53VPCAssociationAuthorizationParseId(id string) (string, st
 }
 
 func route53GetVPCAssociation(conn *route53.Route53, zoneID, vpcID string) (*route53.VPC, error) {
-	input := &route53.ListVPCAssociationAuthorizationsInput{
-		HostedZoneId: aws.String(zoneID),
-		// MaxResults currently defaults to 50
-		// NextToken to be implemented later
-	}
-
-	err := input.Validate()
-
-	if err != nil {
-		return nil, fmt.Errorf("Bad input %s for List VPC Association Authorizations: %s", input.GoString(), err)
-	}
-
-	output, err := conn.ListVPCAssociationAuthorizations(input)
+	vpcs, err := route53ListVPCAssociationAuthorizations(conn, zoneID)
 
 	if err != nil {
 		return nil, err
 	}
 
 	var vpc *route53.VPC
-	for _, zoneVPC := range output.VPCs {
+	for _, zoneVPC := range vpcs {
 		if vpcID == aws.StringValue(zoneVPC.VPCId) {
 			vpc = zoneVPC
 			break
@@ -201,3 +272,31 @@ func route53GetVPCAssociation(conn *route53.Route53, zoneID, vpcID string) (*rou
 
 	return vpc, nil
 }
+
+func route53ListVPCAssociationAuthorizations(conn *route53.Route53, zoneID string) ([]*route53.VPC, error) {
+	input := &route53.ListVPCAssociationAuthorizationsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("Bad input %s for List VPC Association Authorizations: %s", input.GoString(), err)
+	}
+
+	var vpcs []*route53.VPC
+	for {
+		output, err := conn.ListVPCAssociationAuthorizations(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		vpcs = append(vpcs, output.VPCs...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return vpcs, nil
+}